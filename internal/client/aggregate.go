@@ -0,0 +1,144 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+	"github.com/ztstewart/subwayclock/internal/providers/nyct"
+)
+
+// AggregatedNYCTA is a client for the New York City Transit Authority's
+// realtime feeds that fans out across every configured feed ID and presents
+// the result as if it came from a single feed covering the whole system.
+type AggregatedNYCTA struct {
+	clients []*Client
+}
+
+// NewNYCTAAggregate creates a client that queries every feed in feedIDs and
+// merges the results together. An error will be returned if cfg is invalid
+// for any of the feeds.
+func NewNYCTAAggregate(cfg *nyct.Config, feedIDs []string) (*AggregatedNYCTA, error) {
+	clients := make([]*Client, 0, len(feedIDs))
+
+	for _, feedID := range feedIDs {
+		feedCfg := *cfg
+		feedCfg.FeedID = feedID
+
+		c, err := NewNYCTA(&feedCfg)
+		if err != nil {
+			return nil, fmt.Errorf("feed %q: %w", feedID, err)
+		}
+
+		clients = append(clients, c)
+	}
+
+	return &AggregatedNYCTA{clients: clients}, nil
+}
+
+// GetFeed retrieves and merges the current feed information from every
+// configured feed. If some, but not all, of the underlying feeds fail, the
+// merged results from the feeds that succeeded are returned alongside a
+// joined error describing the failures, so a single bad feed doesn't blank
+// the whole board.
+func (a *AggregatedNYCTA) GetFeed() (models.FeedUpdate, error) {
+	updates := make([]models.FeedUpdate, len(a.clients))
+	errs := make([]error, len(a.clients))
+
+	var wg sync.WaitGroup
+	wg.Add(len(a.clients))
+
+	for i, c := range a.clients {
+		go func(i int, c *Client) {
+			defer wg.Done()
+			updates[i], errs[i] = c.GetFeed()
+		}(i, c)
+	}
+
+	wg.Wait()
+
+	return mergeFeedUpdates(updates), errors.Join(errs...)
+}
+
+func mergeFeedUpdates(updates []models.FeedUpdate) models.FeedUpdate {
+	merged := models.FeedUpdate{
+		StationStatus: make(map[string]models.StationStatus, _avgNumStopsPerLine),
+	}
+
+	seenAlerts := make(map[string]bool)
+	vehicleByTrip := make(map[string]models.VehiclePosition)
+
+	for _, update := range updates {
+		for stopID, status := range update.StationStatus {
+			merged.StationStatus[stopID] = mergeStationStatus(merged.StationStatus[stopID], status)
+		}
+
+		for _, alert := range update.Alerts {
+			if seenAlerts[alert.ID] {
+				continue
+			}
+
+			seenAlerts[alert.ID] = true
+			merged.Alerts = append(merged.Alerts, alert)
+		}
+
+		for _, vehicle := range update.Vehicles {
+			existing, ok := vehicleByTrip[vehicle.TripID]
+			if !ok || vehicle.Timestamp.After(existing.Timestamp) {
+				vehicleByTrip[vehicle.TripID] = vehicle
+			}
+		}
+	}
+
+	merged.Vehicles = make([]models.VehiclePosition, 0, len(vehicleByTrip))
+	for _, vehicle := range vehicleByTrip {
+		merged.Vehicles = append(merged.Vehicles, vehicle)
+	}
+
+	for stopID, status := range merged.StationStatus {
+		for dir, trips := range status.StopIDToUpdates {
+			status.StopIDToUpdates[dir] = dedupeAndSortUpdates(trips)
+		}
+		merged.StationStatus[stopID] = status
+	}
+
+	return merged
+}
+
+func mergeStationStatus(dst, src models.StationStatus) models.StationStatus {
+	dst.StopID = src.StopID
+
+	if dst.StopIDToUpdates == nil {
+		dst.StopIDToUpdates = make(map[string][]models.StationUpdate, 2)
+	}
+
+	for dir, trips := range src.StopIDToUpdates {
+		dst.StopIDToUpdates[dir] = append(dst.StopIDToUpdates[dir], trips...)
+	}
+
+	return dst
+}
+
+// dedupeAndSortUpdates removes duplicate trips seen across overlapping feeds
+// (identified by TripID) and re-sorts the remaining updates by arrival time.
+func dedupeAndSortUpdates(updates []models.StationUpdate) []models.StationUpdate {
+	seen := make(map[string]bool, len(updates))
+	deduped := updates[:0]
+
+	for _, u := range updates {
+		if seen[u.TripID] {
+			continue
+		}
+
+		seen[u.TripID] = true
+		deduped = append(deduped, u)
+	}
+
+	sort.Slice(deduped, func(i, j int) bool {
+		return deduped[i].Arrival.Before(deduped[j].Arrival)
+	})
+
+	return deduped
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+)
+
+func TestDedupeAndSortUpdates(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	tests := []struct {
+		name  string
+		in    []models.StationUpdate
+		wantT []string // expected TripIDs, in order
+	}{
+		{
+			name:  "empty",
+			in:    nil,
+			wantT: nil,
+		},
+		{
+			name: "sorts by arrival",
+			in: []models.StationUpdate{
+				{TripID: "b", Arrival: now.Add(2 * time.Minute)},
+				{TripID: "a", Arrival: now.Add(1 * time.Minute)},
+			},
+			wantT: []string{"a", "b"},
+		},
+		{
+			name: "drops duplicate trip IDs seen across overlapping feeds",
+			in: []models.StationUpdate{
+				{TripID: "a", Arrival: now.Add(1 * time.Minute)},
+				{TripID: "b", Arrival: now.Add(2 * time.Minute)},
+				{TripID: "a", Arrival: now.Add(1 * time.Minute)},
+			},
+			wantT: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dedupeAndSortUpdates(tt.in)
+
+			if len(got) != len(tt.wantT) {
+				t.Fatalf("dedupeAndSortUpdates() = %v, want %d entries", got, len(tt.wantT))
+			}
+			for i, tripID := range tt.wantT {
+				if got[i].TripID != tripID {
+					t.Errorf("got[%d].TripID = %q, want %q", i, got[i].TripID, tripID)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeFeedUpdates(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	feedA := models.FeedUpdate{
+		StationStatus: map[string]models.StationStatus{
+			"723": {
+				StopID: "723",
+				StopIDToUpdates: map[string][]models.StationUpdate{
+					"723N": {{TripID: "shared", Arrival: now.Add(5 * time.Minute)}},
+				},
+			},
+		},
+		Alerts: []models.Alert{
+			{ID: "alert-1", Header: "Planned work"},
+		},
+		Vehicles: []models.VehiclePosition{
+			{TripID: "shared", Timestamp: now},
+		},
+	}
+
+	feedB := models.FeedUpdate{
+		StationStatus: map[string]models.StationStatus{
+			"723": {
+				StopID: "723",
+				StopIDToUpdates: map[string][]models.StationUpdate{
+					// Same trip reported again by an overlapping feed, plus
+					// one genuinely new trip.
+					"723N": {
+						{TripID: "shared", Arrival: now.Add(5 * time.Minute)},
+						{TripID: "new", Arrival: now.Add(1 * time.Minute)},
+					},
+				},
+			},
+		},
+		// Same alert ID reported by both feeds should collapse to one.
+		Alerts: []models.Alert{
+			{ID: "alert-1", Header: "Planned work"},
+		},
+		Vehicles: []models.VehiclePosition{
+			{TripID: "shared", Timestamp: now.Add(30 * time.Second)},
+		},
+	}
+
+	merged := mergeFeedUpdates([]models.FeedUpdate{feedA, feedB})
+
+	updates := merged.StationStatus["723"].StopIDToUpdates["723N"]
+	if len(updates) != 2 {
+		t.Fatalf("merged 723N updates = %v, want 2 deduped trips", updates)
+	}
+	if updates[0].TripID != "new" || updates[1].TripID != "shared" {
+		t.Errorf("merged 723N updates not sorted/deduped correctly: %v", updates)
+	}
+
+	if len(merged.Alerts) != 1 {
+		t.Fatalf("merged.Alerts = %v, want 1 deduped alert", merged.Alerts)
+	}
+
+	if len(merged.Vehicles) != 1 {
+		t.Fatalf("merged.Vehicles = %v, want 1 deduped vehicle", merged.Vehicles)
+	}
+	if !merged.Vehicles[0].Timestamp.Equal(now.Add(30 * time.Second)) {
+		t.Errorf("merged vehicle timestamp = %v, want the most recent report", merged.Vehicles[0].Timestamp)
+	}
+}
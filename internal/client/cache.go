@@ -0,0 +1,142 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultRealtimeTTL is how long a parsed realtime feed update is
+// considered fresh before CachingClient will re-fetch it. The underlying
+// MTA feeds only update every ~15-30s, so refreshing more often than this
+// just burns rate-limited API calls.
+//
+// Static GTFS data (stops, routes, trips) has its own, much longer cache
+// interval — see static.DefaultRefreshInterval.
+const DefaultRealtimeTTL = 15 * time.Second
+
+// feedGetter is anything that can retrieve a parsed feed update: a single
+// Client or an AggregatedNYCTA spanning several feeds.
+type feedGetter interface {
+	GetFeed() (models.FeedUpdate, error)
+}
+
+// conditionalFeedGetter is implemented by feedGetters that can skip
+// re-fetching and re-parsing via ETag/If-Modified-Since. CachingClient uses
+// it when the wrapped feedGetter supports it and falls back to a plain
+// GetFeed() otherwise, so it can wrap anything feedGetter-shaped — a single
+// Client or a multi-feed AggregatedNYCTA — at the cost of always doing a
+// full fetch for feedGetters that don't implement it.
+type conditionalFeedGetter interface {
+	getFeedConditional(etag, lastModified string) (models.FeedUpdate, string, string, bool, error)
+}
+
+// CachingClient wraps a feedGetter and caches its parsed feed update for
+// ttl, coalescing concurrent refreshes into a single underlying fetch and,
+// when the wrapped feedGetter supports it, honoring ETag/Last-Modified so a
+// 304 response skips re-parsing the protobuf entirely.
+type CachingClient struct {
+	inner feedGetter
+	ttl   time.Duration
+
+	group singleflight.Group
+
+	mu           sync.Mutex
+	cached       models.FeedUpdate
+	cachedAt     time.Time
+	etag         string
+	lastModified string
+}
+
+// NewCachingClient creates a CachingClient wrapping inner that refreshes at
+// most once per ttl. inner may be a single Client or an AggregatedNYCTA.
+func NewCachingClient(inner feedGetter, ttl time.Duration) *CachingClient {
+	return &CachingClient{
+		inner: inner,
+		ttl:   ttl,
+	}
+}
+
+// GetFeed returns the cached feed update if it's younger than ttl, and
+// otherwise refreshes it. Concurrent callers during a refresh share a single
+// underlying HTTP request.
+func (c *CachingClient) GetFeed() (models.FeedUpdate, error) {
+	if update, fresh := c.freshCached(); fresh {
+		return update, nil
+	}
+
+	v, err, _ := c.group.Do("feed", func() (interface{}, error) {
+		return c.refresh()
+	})
+	if err != nil {
+		return models.FeedUpdate{}, err
+	}
+
+	return v.(models.FeedUpdate), nil
+}
+
+func (c *CachingClient) freshCached() (models.FeedUpdate, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cachedAt.IsZero() || time.Since(c.cachedAt) >= c.ttl {
+		return models.FeedUpdate{}, false
+	}
+
+	return c.cached, true
+}
+
+func (c *CachingClient) refresh() (models.FeedUpdate, error) {
+	// Another caller may have already refreshed while we were waiting to
+	// enter the singleflight group.
+	if update, fresh := c.freshCached(); fresh {
+		return update, nil
+	}
+
+	cond, ok := c.inner.(conditionalFeedGetter)
+	if !ok {
+		return c.refreshUnconditional()
+	}
+
+	c.mu.Lock()
+	etag, lastModified := c.etag, c.lastModified
+	c.mu.Unlock()
+
+	update, newETag, newLastModified, notModified, err := cond.getFeedConditional(etag, lastModified)
+	if err != nil {
+		return models.FeedUpdate{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cachedAt = time.Now()
+	if notModified {
+		return c.cached, nil
+	}
+
+	c.cached = update
+	c.etag = newETag
+	c.lastModified = newLastModified
+
+	return c.cached, nil
+}
+
+// refreshUnconditional re-fetches via a plain GetFeed(), for feedGetters
+// (like AggregatedNYCTA) that can't skip the work via ETag/Last-Modified.
+func (c *CachingClient) refreshUnconditional() (models.FeedUpdate, error) {
+	update, err := c.inner.GetFeed()
+	if err != nil {
+		return models.FeedUpdate{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cached = update
+	c.cachedAt = time.Now()
+
+	return c.cached, nil
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+)
+
+// fakeGetter is a feedGetter that counts calls and returns a fresh
+// FeedUpdate (stamped with the call count) each time, optionally blocking
+// until release is closed so tests can hold a refresh open to exercise
+// singleflight coalescing.
+type fakeGetter struct {
+	calls   int32
+	release chan struct{}
+}
+
+func (f *fakeGetter) GetFeed() (models.FeedUpdate, error) {
+	n := atomic.AddInt32(&f.calls, 1)
+
+	if f.release != nil {
+		<-f.release
+	}
+
+	return models.FeedUpdate{
+		Alerts: []models.Alert{{ID: "call", Header: string(rune('0' + n))}},
+	}, nil
+}
+
+func TestCachingClientReusesWithinTTL(t *testing.T) {
+	inner := &fakeGetter{}
+	c := NewCachingClient(inner, time.Hour)
+
+	first, err := c.GetFeed()
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+	second, err := c.GetFeed()
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+
+	if inner.calls != 1 {
+		t.Errorf("inner.calls = %d, want 1", inner.calls)
+	}
+	if first.Alerts[0].Header != second.Alerts[0].Header {
+		t.Errorf("second GetFeed() returned a different update than the cached one: %+v vs %+v", first, second)
+	}
+}
+
+func TestCachingClientRefreshesAfterTTL(t *testing.T) {
+	inner := &fakeGetter{}
+	c := NewCachingClient(inner, time.Millisecond)
+
+	if _, err := c.GetFeed(); err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, err := c.GetFeed(); err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+
+	if inner.calls != 2 {
+		t.Errorf("inner.calls = %d, want 2 after TTL expiry", inner.calls)
+	}
+}
+
+func TestCachingClientCoalescesConcurrentRefreshes(t *testing.T) {
+	inner := &fakeGetter{release: make(chan struct{})}
+	c := NewCachingClient(inner, time.Hour)
+
+	const callers = 8
+
+	var wg sync.WaitGroup
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetFeed(); err != nil {
+				t.Errorf("GetFeed() error = %v", err)
+			}
+		}()
+	}
+
+	// Give every goroutine a chance to block inside the singleflight-backed
+	// refresh before letting the one real fetch complete.
+	time.Sleep(10 * time.Millisecond)
+	close(inner.release)
+
+	wg.Wait()
+
+	if inner.calls != 1 {
+		t.Errorf("inner.calls = %d, want 1 shared refresh across %d concurrent callers", inner.calls, callers)
+	}
+}
+
+// conditionalFakeGetter implements conditionalFeedGetter so CachingClient
+// exercises its ETag/If-Modified-Since path instead of refreshUnconditional.
+type conditionalFakeGetter struct {
+	calls int
+
+	// notModifiedAfter, if > 0, makes every call after the first
+	// notModifiedAfter calls report notModified=true.
+	notModifiedAfter int
+}
+
+func (c *conditionalFakeGetter) GetFeed() (models.FeedUpdate, error) {
+	update, _, _, _, err := c.getFeedConditional("", "")
+	return update, err
+}
+
+func (c *conditionalFakeGetter) getFeedConditional(etag, lastModified string) (models.FeedUpdate, string, string, bool, error) {
+	c.calls++
+
+	if c.notModifiedAfter > 0 && c.calls > c.notModifiedAfter {
+		return models.FeedUpdate{}, etag, lastModified, true, nil
+	}
+
+	return models.FeedUpdate{
+		Alerts: []models.Alert{{ID: "call", Header: string(rune('0' + c.calls))}},
+	}, "etag-v1", "last-modified-v1", false, nil
+}
+
+func TestCachingClientUses304ToSkipReparse(t *testing.T) {
+	inner := &conditionalFakeGetter{notModifiedAfter: 1}
+	c := NewCachingClient(inner, time.Millisecond)
+
+	first, err := c.GetFeed()
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	second, err := c.GetFeed()
+	if err != nil {
+		t.Fatalf("GetFeed() error = %v", err)
+	}
+
+	if inner.calls != 2 {
+		t.Errorf("inner.calls = %d, want 2 (one real fetch, one 304)", inner.calls)
+	}
+	if second.Alerts[0].Header != first.Alerts[0].Header {
+		t.Errorf("a 304 response should keep serving the previously cached update, got %+v want %+v", second, first)
+	}
+}
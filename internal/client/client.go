@@ -1,6 +1,7 @@
 package client
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"sort"
@@ -9,81 +10,103 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/ztstewart/subwayclock/internal/client/transit_realtime"
 	"github.com/ztstewart/subwayclock/internal/models"
-)
-
-// The MTA considers one physical station to be multiple stop IDs depending
-// on the direction a train is travelling. For example, Grand Central on the 7
-// line would have two stop IDs: 723N and 723S. 723N would be Grand Central on
-// the 7 line in the direction of travel in Queens.
-const (
-	_northboundSuffx = 'N'
-	_soutboundSuffx  = 'S'
+	"github.com/ztstewart/subwayclock/internal/providers/nyct"
 )
 
 const _avgNumStopsPerLine = 30
 
-const _baseURL = "https://api-endpoint.mta.info/Dataservice/mtagtfsfeeds/nyct%2Fgtfs-"
-
-// Config defines how to configure the subway client.
-type Config struct {
-	APIKey string `yaml:"api_key" json:"api_key"`
-	FeedID string `yaml:"feed_id" json:"feed_id"`
+// Client is a GTFS-Realtime client for a single feed, parameterized by a
+// Provider that knows how to reach and authenticate to that feed.
+type Client struct {
+	provider Provider
+	url      string
 }
 
-// NYCTA is a client for the New York City Transit Authority's realtime feed.
-type NYCTA struct {
-	cfg *Config
-	url string
+// NewClient creates a Client for provider.
+func NewClient(provider Provider) *Client {
+	return &Client{
+		provider: provider,
+		url:      provider.FeedURL(),
+	}
 }
 
-// NewNYCTA creates a new New York City Transit Authority client.
+// NewNYCTA creates a Client for the New York City Transit Authority's
+// realtime subway feed. It's a thin convenience wrapper around
+// NewClient(nyct.New(cfg)) for the common case.
 // An error will be returned if the configuration is invalid.
-func NewNYCTA(cfg *Config) (*NYCTA, error) {
-	url := _baseURL + cfg.FeedID
-
-	return &NYCTA{
-		cfg: cfg,
-		url: url,
-	}, nil
+func NewNYCTA(cfg *nyct.Config) (*Client, error) {
+	return NewClient(nyct.New(*cfg)), nil
 }
 
 // GetFeed retrieves the current feed information.
 // Currently for testing purposes it returns a JSON string.
-func (n *NYCTA) GetFeed() (models.FeedUpdate, error) {
-	client := http.DefaultClient
+func (c *Client) GetFeed() (models.FeedUpdate, error) {
+	update, _, _, _, err := c.getFeedConditional("", "")
+	return update, err
+}
 
-	req, err := http.NewRequest("GET", n.url, nil)
+// getFeedConditional fetches the feed, sending If-None-Match/If-Modified-Since
+// headers when etag/lastModified are non-empty so the server can respond with
+// 304 Not Modified instead of the full payload. It returns the new ETag and
+// Last-Modified values from the response, and notModified=true when the
+// server confirmed nothing has changed (in which case the returned
+// models.FeedUpdate is the zero value and should be ignored).
+func (c *Client) getFeedConditional(etag, lastModified string) (update models.FeedUpdate, newETag, newLastModified string, notModified bool, err error) {
+	httpClient := http.DefaultClient
+
+	req, err := http.NewRequest("GET", c.url, nil)
 	if err != nil {
-		return models.FeedUpdate{}, err
+		return models.FeedUpdate{}, "", "", false, err
 	}
 
-	req.Header.Add("x-api-key", n.cfg.APIKey)
-	resp, err := client.Do(req)
-	if err != nil {
-		return models.FeedUpdate{}, err
+	c.provider.AuthorizeRequest(req)
+	if etag != "" {
+		req.Header.Add("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Add("If-Modified-Since", lastModified)
 	}
 
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return models.FeedUpdate{}, "", "", false, err
+	}
 	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return models.FeedUpdate{}, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"), true, nil
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return models.FeedUpdate{}, err
+		return models.FeedUpdate{}, "", "", false, err
 	}
 
 	feedMessage := &transit_realtime.FeedMessage{}
 	if err := proto.Unmarshal(body, feedMessage); err != nil {
-		return models.FeedUpdate{}, err
+		return models.FeedUpdate{}, "", "", false, err
+	}
+
+	update, err = c.parseStatus(feedMessage)
+	if err != nil {
+		return models.FeedUpdate{}, "", "", false, err
 	}
 
-	return n.parseStatus(feedMessage)
+	return update, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified"), false, nil
 }
 
-func (n *NYCTA) parseStatus(feedMessage *transit_realtime.FeedMessage) (models.FeedUpdate, error) {
+func (c *Client) parseStatus(feedMessage *transit_realtime.FeedMessage) (models.FeedUpdate, error) {
 	stopToTimestamp := make(map[string][]models.StationUpdate, _avgNumStopsPerLine)
-	var alerts []*transit_realtime.Alert
+	var alertEntities []*transit_realtime.FeedEntity
+	var vehicles []models.VehiclePosition
 
 	for _, e := range feedMessage.Entity {
 		if e.Alert != nil {
-			alerts = append(alerts, e.Alert)
+			alertEntities = append(alertEntities, e)
+		}
+
+		if e.Vehicle != nil {
+			vehicles = append(vehicles, parseVehiclePosition(e.Vehicle))
 		}
 
 		if e.TripUpdate == nil || e.TripUpdate.Trip == nil {
@@ -112,45 +135,17 @@ func (n *NYCTA) parseStatus(feedMessage *transit_realtime.FeedMessage) (models.F
 
 	update := models.FeedUpdate{
 		StationStatus: make(map[string]models.StationStatus, len(stopToTimestamp)),
-		Alerts:        make([]models.Alert, len(alerts)),
+		Alerts:        make([]models.Alert, len(alertEntities)),
+		Vehicles:      vehicles,
 	}
 
-	for i, alert := range alerts {
-		var header string
-		for _, trans := range alert.GetHeaderText().GetTranslation() {
-			if trans.Text != nil {
-				header = *trans.Text
-				break
-			}
-		}
-
-		update.Alerts[i] = models.Alert{
-			Effect: alert.GetEffect().String(),
-			Header: header,
-		}
+	for i, e := range alertEntities {
+		update.Alerts[i] = parseAlert(e)
 	}
 
 	for k, v := range stopToTimestamp {
-		last := k[len(k)-1]
-
-		// Stop ID has a direction suffix. Don't use it as the stop ID.
-		if len(k) > 1 && (last == _northboundSuffx || last == _soutboundSuffx) {
-			stopID := k[:len(k)-1]
-			stop := update.StationStatus[stopID]
-			stop.StopID = stopID
+		stopID, _ := c.provider.StopIDDirection(k)
 
-			if stop.StopIDToUpdates == nil {
-				stop.StopIDToUpdates = make(map[string][]models.StationUpdate, 2)
-			}
-			stop.StopIDToUpdates[k] = v
-
-			update.StationStatus[stopID] = stop
-
-			continue
-		}
-
-		// No direction suffix.
-		stopID := k
 		stop := update.StationStatus[stopID]
 		stop.StopID = stopID
 
@@ -160,8 +155,104 @@ func (n *NYCTA) parseStatus(feedMessage *transit_realtime.FeedMessage) (models.F
 		stop.StopIDToUpdates[k] = v
 
 		update.StationStatus[stopID] = stop
-
 	}
 
 	return update, nil
 }
+
+// parseAlert converts a GTFS-Realtime Alert entity into a models.Alert,
+// keeping every header translation instead of picking one.
+func parseAlert(e *transit_realtime.FeedEntity) models.Alert {
+	alert := e.GetAlert()
+
+	translations := make(map[string]string, len(alert.GetHeaderText().GetTranslation()))
+	var header string
+	for _, trans := range alert.GetHeaderText().GetTranslation() {
+		if trans.Text == nil {
+			continue
+		}
+
+		lang := trans.GetLanguage()
+		translations[lang] = trans.GetText()
+
+		if header == "" {
+			header = trans.GetText()
+		}
+	}
+
+	var description string
+	for _, trans := range alert.GetDescriptionText().GetTranslation() {
+		if trans.Text != nil {
+			description = trans.GetText()
+			break
+		}
+	}
+
+	activePeriods := make([]models.ActivePeriod, len(alert.GetActivePeriod()))
+	for i, p := range alert.GetActivePeriod() {
+		var period models.ActivePeriod
+		if p.Start != nil {
+			period.Start = time.Unix(int64(p.GetStart()), 0)
+		}
+		if p.End != nil {
+			period.End = time.Unix(int64(p.GetEnd()), 0)
+		}
+		activePeriods[i] = period
+	}
+
+	informedEntities := make([]models.InformedEntity, len(alert.GetInformedEntity()))
+	for i, e := range alert.GetInformedEntity() {
+		var directionID string
+		if e.DirectionId != nil {
+			directionID = fmt.Sprint(e.GetDirectionId())
+		}
+
+		informedEntities[i] = models.InformedEntity{
+			RouteID:     e.GetRouteId(),
+			StopID:      e.GetStopId(),
+			TripID:      e.GetTrip().GetTripId(),
+			DirectionID: directionID,
+		}
+	}
+
+	return models.Alert{
+		ID:               e.GetId(),
+		Effect:           alert.GetEffect().String(),
+		Header:           header,
+		Description:      description,
+		Cause:            alert.GetCause().String(),
+		SeverityLevel:    alert.GetSeverityLevel().String(),
+		ActivePeriods:    activePeriods,
+		InformedEntities: informedEntities,
+		Translations:     translations,
+	}
+}
+
+// parseVehiclePosition converts a GTFS-Realtime VehiclePosition entity into
+// a models.VehiclePosition. Fields the agency didn't populate are left at
+// their zero value — notably, an absent Timestamp stays a zero time.Time
+// rather than becoming the Unix epoch, so mergeFeedUpdates's "most recent
+// report wins" comparison never lets a vehicle report with no timestamp
+// clobber a populated one from another feed.
+func parseVehiclePosition(v *transit_realtime.VehiclePosition) models.VehiclePosition {
+	pos := models.VehiclePosition{
+		CurrentStopID: v.GetStopId(),
+		CurrentStatus: v.GetCurrentStatus().String(),
+		TripID:        v.GetTrip().GetTripId(),
+		RouteID:       v.GetTrip().GetRouteId(),
+	}
+
+	if v.Timestamp != nil {
+		pos.Timestamp = time.Unix(int64(v.GetTimestamp()), 0)
+	}
+
+	if p := v.GetPosition(); p != nil {
+		pos.Position = &models.Position{
+			Lat:     float64(p.GetLatitude()),
+			Lon:     float64(p.GetLongitude()),
+			Bearing: float64(p.GetBearing()),
+		}
+	}
+
+	return pos
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/client/transit_realtime"
+	"github.com/ztstewart/subwayclock/internal/models"
+)
+
+func strPtr(s string) *string   { return &s }
+func u64Ptr(v uint64) *uint64   { return &v }
+func f32Ptr(v float32) *float32 { return &v }
+
+func TestParseVehiclePosition(t *testing.T) {
+	status := transit_realtime.VehiclePosition_STOPPED_AT
+
+	tests := []struct {
+		name string
+		in   *transit_realtime.VehiclePosition
+		want models.VehiclePosition
+	}{
+		{
+			name: "fully populated",
+			in: &transit_realtime.VehiclePosition{
+				Trip:          &transit_realtime.TripDescriptor{TripId: strPtr("t1"), RouteId: strPtr("7")},
+				StopId:        strPtr("723N"),
+				CurrentStatus: &status,
+				Timestamp:     u64Ptr(1000),
+				Position:      &transit_realtime.Position{Latitude: f32Ptr(40.5), Longitude: f32Ptr(-74.25), Bearing: f32Ptr(90)},
+			},
+			want: models.VehiclePosition{
+				TripID:        "t1",
+				RouteID:       "7",
+				CurrentStopID: "723N",
+				CurrentStatus: "STOPPED_AT",
+				Timestamp:     time.Unix(1000, 0),
+				Position:      &models.Position{Lat: 40.5, Lon: -74.25, Bearing: 90},
+			},
+		},
+		{
+			name: "absent timestamp stays the zero time, not the Unix epoch",
+			in: &transit_realtime.VehiclePosition{
+				Trip: &transit_realtime.TripDescriptor{TripId: strPtr("t2")},
+			},
+			want: models.VehiclePosition{
+				TripID:        "t2",
+				CurrentStatus: "IN_TRANSIT_TO", // proto default when current_status is missing
+			},
+		},
+		{
+			name: "absent position leaves Position nil",
+			in: &transit_realtime.VehiclePosition{
+				Trip:      &transit_realtime.TripDescriptor{TripId: strPtr("t3")},
+				Timestamp: u64Ptr(500),
+			},
+			want: models.VehiclePosition{
+				TripID:        "t3",
+				CurrentStatus: "IN_TRANSIT_TO",
+				Timestamp:     time.Unix(500, 0),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseVehiclePosition(tt.in)
+
+			if got.TripID != tt.want.TripID || got.RouteID != tt.want.RouteID ||
+				got.CurrentStopID != tt.want.CurrentStopID || got.CurrentStatus != tt.want.CurrentStatus {
+				t.Fatalf("parseVehiclePosition() = %+v, want %+v", got, tt.want)
+			}
+			if !got.Timestamp.Equal(tt.want.Timestamp) {
+				t.Errorf("Timestamp = %v, want %v", got.Timestamp, tt.want.Timestamp)
+			}
+			if (got.Position == nil) != (tt.want.Position == nil) {
+				t.Fatalf("Position = %+v, want %+v", got.Position, tt.want.Position)
+			}
+			if got.Position != nil && *got.Position != *tt.want.Position {
+				t.Errorf("Position = %+v, want %+v", got.Position, tt.want.Position)
+			}
+		})
+	}
+}
+
+func TestAbsentTimestampNeverClobbersAPopulatedReportInMerge(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	// Simulates two overlapping feeds reporting the same trip: one with a
+	// real timestamp, one where the agency omitted it. Whichever order they
+	// arrive in, the populated timestamp should win, since the zero time
+	// from parseVehiclePosition's absent-timestamp case is never After a
+	// real one.
+	withTimestamp := models.VehiclePosition{TripID: "shared", Timestamp: now}
+	withoutTimestamp := models.VehiclePosition{TripID: "shared"}
+
+	feedWithFirst := models.FeedUpdate{
+		StationStatus: map[string]models.StationStatus{},
+		Vehicles:      []models.VehiclePosition{withTimestamp},
+	}
+	feedWithoutSecond := models.FeedUpdate{
+		StationStatus: map[string]models.StationStatus{},
+		Vehicles:      []models.VehiclePosition{withoutTimestamp},
+	}
+
+	merged := mergeFeedUpdates([]models.FeedUpdate{feedWithFirst, feedWithoutSecond})
+	if len(merged.Vehicles) != 1 || !merged.Vehicles[0].Timestamp.Equal(now) {
+		t.Fatalf("merged.Vehicles = %+v, want the populated timestamp to survive", merged.Vehicles)
+	}
+
+	mergedReversed := mergeFeedUpdates([]models.FeedUpdate{feedWithoutSecond, feedWithFirst})
+	if len(mergedReversed.Vehicles) != 1 || !mergedReversed.Vehicles[0].Timestamp.Equal(now) {
+		t.Fatalf("merged.Vehicles = %+v, want the populated timestamp to survive regardless of feed order", mergedReversed.Vehicles)
+	}
+}
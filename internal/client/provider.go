@@ -0,0 +1,22 @@
+package client
+
+import "net/http"
+
+// Provider adapts Client to a specific GTFS-Realtime publisher: where to
+// fetch its feed, how to authenticate to it, and how it encodes direction
+// into a stop ID. Implementing Provider for a new agency is all that's
+// needed to reuse Client's fetching, caching, and parsing logic.
+type Provider interface {
+	// FeedURL returns the URL to fetch the GTFS-Realtime feed from.
+	FeedURL() string
+
+	// AuthorizeRequest adds whatever authentication the provider requires
+	// to req before it's sent.
+	AuthorizeRequest(req *http.Request)
+
+	// StopIDDirection splits a raw stop ID into its base station ID and a
+	// direction suffix, e.g. the MTA's "723N" becomes ("723", "N").
+	// Providers that don't encode direction in the stop ID should return
+	// dir == "".
+	StopIDDirection(stopID string) (base, dir string)
+}
@@ -0,0 +1,328 @@
+// Package static downloads and parses an agency's supplemental static GTFS
+// zip (stops.txt, routes.txt, trips.txt) so realtime feed data can be
+// enriched with human-readable stop names, route metadata, and headsigns.
+package static
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+)
+
+// DefaultRefreshInterval is how often Source re-downloads the static feed.
+// MTA's supplemental GTFS is effectively a weekly publish, so there's no
+// value in refreshing more often than this.
+const DefaultRefreshInterval = 7 * 24 * time.Hour
+
+const (
+	_stopsFile  = "stops.txt"
+	_routesFile = "routes.txt"
+	_tripsFile  = "trips.txt"
+)
+
+// Stop is a single row of stops.txt.
+type Stop struct {
+	StopID        string
+	StopName      string
+	ParentStation string
+	Latitude      float64
+	Longitude     float64
+}
+
+// Route is a single row of routes.txt.
+type Route struct {
+	RouteID        string
+	RouteShortName string
+	RouteColor     string
+}
+
+// Trip is a single row of trips.txt.
+type Trip struct {
+	TripID   string
+	RouteID  string
+	Headsign string
+}
+
+// Data is the parsed static GTFS dataset, indexed by ID for fast lookup.
+type Data struct {
+	Stops  map[string]Stop
+	Routes map[string]Route
+	Trips  map[string]Trip
+}
+
+// Source downloads a static GTFS zip from URL, caches the zip on disk at
+// CachePath and the parsed result in memory, refreshing at most once per
+// RefreshInterval.
+type Source struct {
+	URL             string
+	CachePath       string
+	RefreshInterval time.Duration
+
+	mu        sync.Mutex
+	data      *Data
+	fetchedAt time.Time
+}
+
+// NewSource creates a Source that downloads url and caches the zip at
+// cachePath. A zero refreshInterval uses DefaultRefreshInterval.
+func NewSource(url, cachePath string, refreshInterval time.Duration) *Source {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+
+	return &Source{
+		URL:             url,
+		CachePath:       cachePath,
+		RefreshInterval: refreshInterval,
+	}
+}
+
+// Data returns the current static dataset, downloading it if it's never
+// been fetched, loading it from disk if a fresh enough cached copy exists,
+// or re-downloading it if the cache is stale. If a refresh fails, a
+// previously loaded (possibly stale) dataset is returned rather than an
+// error, so a transient network blip doesn't take stop names away from a
+// UI that already has them.
+func (s *Source) Data() (*Data, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.data != nil && time.Since(s.fetchedAt) < s.RefreshInterval {
+		return s.data, nil
+	}
+
+	if fi, err := os.Stat(s.CachePath); err == nil && time.Since(fi.ModTime()) < s.RefreshInterval {
+		if data, err := parseZip(s.CachePath); err == nil {
+			s.data = data
+			s.fetchedAt = fi.ModTime()
+			return s.data, nil
+		}
+	}
+
+	data, err := s.download()
+	if err != nil {
+		if s.data != nil {
+			return s.data, nil
+		}
+		if data, perr := parseZip(s.CachePath); perr == nil {
+			return data, nil
+		}
+		return nil, err
+	}
+
+	s.data = data
+	s.fetchedAt = time.Now()
+
+	return s.data, nil
+}
+
+func (s *Source) download() (*Data, error) {
+	resp, err := http.Get(s.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("static: GET %s: %s", s.URL, resp.Status)
+	}
+
+	// Download to a temp file alongside CachePath and only replace it once
+	// we have a complete, parseable zip, so a dropped connection or
+	// truncated response can't corrupt a previously-good cache.
+	tmp, err := os.CreateTemp(filepath.Dir(s.CachePath), filepath.Base(s.CachePath)+".tmp-*")
+	if err != nil {
+		return nil, err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+
+	data, err := parseZip(tmpPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, s.CachePath); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func parseZip(path string) (*Data, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	data := &Data{
+		Stops:  make(map[string]Stop),
+		Routes: make(map[string]Route),
+		Trips:  make(map[string]Trip),
+	}
+
+	for _, f := range zr.File {
+		switch f.Name {
+		case _stopsFile:
+			if err := readCSV(f, parseStopRow(data)); err != nil {
+				return nil, fmt.Errorf("static: %s: %w", _stopsFile, err)
+			}
+		case _routesFile:
+			if err := readCSV(f, parseRouteRow(data)); err != nil {
+				return nil, fmt.Errorf("static: %s: %w", _routesFile, err)
+			}
+		case _tripsFile:
+			if err := readCSV(f, parseTripRow(data)); err != nil {
+				return nil, fmt.Errorf("static: %s: %w", _tripsFile, err)
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// readCSV reads f as a GTFS CSV file and calls handle once per data row with
+// a map from column name to that row's value for it.
+func readCSV(f *zip.File, handle func(row map[string]string)) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	r := csv.NewReader(rc)
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return err
+	}
+
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		row := make(map[string]string, len(header))
+		for i, col := range header {
+			if i < len(record) {
+				row[col] = record[i]
+			}
+		}
+
+		handle(row)
+	}
+
+	return nil
+}
+
+func parseStopRow(data *Data) func(row map[string]string) {
+	return func(row map[string]string) {
+		lat, _ := strconv.ParseFloat(row["stop_lat"], 64)
+		lon, _ := strconv.ParseFloat(row["stop_lon"], 64)
+
+		stopID := row["stop_id"]
+		data.Stops[stopID] = Stop{
+			StopID:        stopID,
+			StopName:      row["stop_name"],
+			ParentStation: row["parent_station"],
+			Latitude:      lat,
+			Longitude:     lon,
+		}
+	}
+}
+
+func parseRouteRow(data *Data) func(row map[string]string) {
+	return func(row map[string]string) {
+		routeID := row["route_id"]
+		data.Routes[routeID] = Route{
+			RouteID:        routeID,
+			RouteShortName: row["route_short_name"],
+			RouteColor:     row["route_color"],
+		}
+	}
+}
+
+func parseTripRow(data *Data) func(row map[string]string) {
+	return func(row map[string]string) {
+		tripID := row["trip_id"]
+		data.Trips[tripID] = Trip{
+			TripID:   tripID,
+			RouteID:  row["route_id"],
+			Headsign: row["trip_headsign"],
+		}
+	}
+}
+
+// Enrich returns a copy of update with stop names/coordinates and
+// route/headsign metadata filled in, matching StationStatus entries by stop
+// ID and StationUpdate entries by trip ID. It never modifies update or any
+// map/slice reachable from it, so it's safe to call concurrently on a
+// models.FeedUpdate shared by multiple callers — e.g. the one
+// client.CachingClient hands out to every caller within its TTL window.
+func Enrich(update models.FeedUpdate, data *Data) models.FeedUpdate {
+	enriched := models.FeedUpdate{
+		StationStatus: make(map[string]models.StationStatus, len(update.StationStatus)),
+		Alerts:        update.Alerts,
+		Vehicles:      update.Vehicles,
+	}
+
+	for stopID, status := range update.StationStatus {
+		if stop, ok := data.Stops[stopID]; ok {
+			status.StopName = stop.StopName
+			status.ParentStation = stop.ParentStation
+			status.Latitude = stop.Latitude
+			status.Longitude = stop.Longitude
+		}
+
+		stopIDToUpdates := make(map[string][]models.StationUpdate, len(status.StopIDToUpdates))
+		for dir, updates := range status.StopIDToUpdates {
+			enrichedUpdates := make([]models.StationUpdate, len(updates))
+			for i, su := range updates {
+				trip, ok := data.Trips[su.TripID]
+				if !ok {
+					enrichedUpdates[i] = su
+					continue
+				}
+
+				su.RouteID = trip.RouteID
+				su.Headsign = trip.Headsign
+
+				if route, ok := data.Routes[trip.RouteID]; ok {
+					su.RouteShortName = route.RouteShortName
+					su.RouteColor = route.RouteColor
+				}
+
+				enrichedUpdates[i] = su
+			}
+			stopIDToUpdates[dir] = enrichedUpdates
+		}
+		status.StopIDToUpdates = stopIDToUpdates
+
+		enriched.StationStatus[stopID] = status
+	}
+
+	return enriched
+}
@@ -0,0 +1,320 @@
+package static
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+)
+
+// mapIdentity returns the underlying pointer of a map, so tests can tell
+// whether two map variables alias the same storage.
+func mapIdentity(m interface{}) uintptr {
+	return reflect.ValueOf(m).Pointer()
+}
+
+// buildZip returns a GTFS static zip containing stops.txt, routes.txt, and
+// trips.txt with the given bodies (header row plus data rows, as they'd
+// appear on disk).
+func buildZip(t *testing.T, stopsCSV, routesCSV, tripsCSV string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	for name, body := range map[string]string{
+		_stopsFile:  stopsCSV,
+		_routesFile: routesCSV,
+		_tripsFile:  tripsCSV,
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zw.Create(%q): %v", name, err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatalf("write %q: %v", name, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zw.Close(): %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func validZipBytes(t *testing.T) []byte {
+	t.Helper()
+	return buildZip(t,
+		"stop_id,stop_name,parent_station,stop_lat,stop_lon\n723,Times Sq-42 St,,40.755,-73.986\n",
+		"route_id,route_short_name,route_color\n7,7,B933AD\n",
+		"trip_id,route_id,trip_headsign\nt1,7,Flushing\n",
+	)
+}
+
+func TestEnrichReturnsACopyAndDoesNotMutateInput(t *testing.T) {
+	data := &Data{
+		Stops:  map[string]Stop{"723": {StopID: "723", StopName: "Times Sq-42 St"}},
+		Routes: map[string]Route{"7": {RouteID: "7", RouteShortName: "7"}},
+		Trips:  map[string]Trip{"t1": {TripID: "t1", RouteID: "7", Headsign: "Flushing"}},
+	}
+
+	originalUpdates := []models.StationUpdate{{TripID: "t1"}}
+	update := models.FeedUpdate{
+		StationStatus: map[string]models.StationStatus{
+			"723": {
+				StopID: "723",
+				StopIDToUpdates: map[string][]models.StationUpdate{
+					"723N": originalUpdates,
+				},
+			},
+		},
+	}
+
+	enriched := Enrich(update, data)
+
+	// The returned copy should be enriched...
+	gotUpdate := enriched.StationStatus["723"].StopIDToUpdates["723N"][0]
+	if gotUpdate.Headsign != "Flushing" || gotUpdate.RouteShortName != "7" {
+		t.Fatalf("enriched update = %+v, want Headsign/RouteShortName filled in", gotUpdate)
+	}
+	if enriched.StationStatus["723"].StopName != "Times Sq-42 St" {
+		t.Fatalf("enriched status = %+v, want StopName filled in", enriched.StationStatus["723"])
+	}
+
+	// ...but the input's maps and slices must be untouched.
+	origStatus := update.StationStatus["723"]
+	if origStatus.StopName != "" {
+		t.Errorf("Enrich mutated the input's StopName: %q", origStatus.StopName)
+	}
+	if origStatus.StopIDToUpdates["723N"][0].Headsign != "" {
+		t.Errorf("Enrich mutated the input's StationUpdate in place: %+v", origStatus.StopIDToUpdates["723N"][0])
+	}
+	if mapIdentity(update.StationStatus) == mapIdentity(enriched.StationStatus) {
+		t.Errorf("Enrich returned the same StationStatus map as the input")
+	}
+	if mapIdentity(origStatus.StopIDToUpdates) == mapIdentity(enriched.StationStatus["723"].StopIDToUpdates) {
+		t.Errorf("Enrich returned the same StopIDToUpdates map as the input")
+	}
+}
+
+func TestEnrichLeavesUnmatchedEntriesAlone(t *testing.T) {
+	data := &Data{Stops: map[string]Stop{}, Routes: map[string]Route{}, Trips: map[string]Trip{}}
+
+	update := models.FeedUpdate{
+		StationStatus: map[string]models.StationStatus{
+			"999": {
+				StopID: "999",
+				StopIDToUpdates: map[string][]models.StationUpdate{
+					"999N": {{TripID: "unknown-trip"}},
+				},
+			},
+		},
+	}
+
+	enriched := Enrich(update, data)
+
+	got := enriched.StationStatus["999"].StopIDToUpdates["999N"][0]
+	if got.TripID != "unknown-trip" || got.Headsign != "" || got.RouteID != "" {
+		t.Errorf("enriched unmatched update = %+v, want it passed through unchanged", got)
+	}
+}
+
+// writeZipFile writes zip data to path and sets its mtime to modTime.
+func writeZipFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile(%q): %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Chtimes(%q): %v", path, err)
+	}
+}
+
+func TestSourceDataPrefersFreshInMemoryOverEverythingElse(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "gtfs.zip")
+
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s := NewSource(srv.URL, cachePath, time.Hour)
+	s.data = &Data{Stops: map[string]Stop{"in-memory": {}}}
+	s.fetchedAt = time.Now()
+
+	data, err := s.Data()
+	if err != nil {
+		t.Fatalf("Data() error = %v", err)
+	}
+	if _, ok := data.Stops["in-memory"]; !ok {
+		t.Errorf("Data() = %+v, want the fresh in-memory dataset", data)
+	}
+	if hits != 0 {
+		t.Errorf("Data() hit the network %d times, want 0 when in-memory data is fresh", hits)
+	}
+}
+
+func TestSourceDataPrefersFreshOnDiskOverDownload(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "gtfs.zip")
+	writeZipFile(t, cachePath, validZipBytes(t), time.Now())
+
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s := NewSource(srv.URL, cachePath, time.Hour)
+
+	data, err := s.Data()
+	if err != nil {
+		t.Fatalf("Data() error = %v", err)
+	}
+	if _, ok := data.Stops["723"]; !ok {
+		t.Errorf("Data() = %+v, want the on-disk dataset", data)
+	}
+	if hits != 0 {
+		t.Errorf("Data() hit the network %d times, want 0 when the on-disk cache is fresh", hits)
+	}
+}
+
+func TestSourceDataDownloadsWhenMemoryAndDiskAreStale(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "gtfs.zip")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(validZipBytes(t))
+	}))
+	defer srv.Close()
+
+	s := NewSource(srv.URL, cachePath, time.Hour)
+
+	data, err := s.Data()
+	if err != nil {
+		t.Fatalf("Data() error = %v", err)
+	}
+	if _, ok := data.Stops["723"]; !ok {
+		t.Errorf("Data() = %+v, want the downloaded dataset", data)
+	}
+	if _, err := os.Stat(cachePath); err != nil {
+		t.Errorf("Data() should have written the downloaded zip to %q: %v", cachePath, err)
+	}
+}
+
+func TestSourceDataFallsBackToStaleInMemoryOnDownloadFailure(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "gtfs.zip")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s := NewSource(srv.URL, cachePath, time.Hour)
+	s.data = &Data{Stops: map[string]Stop{"stale-in-memory": {}}}
+	s.fetchedAt = time.Now().Add(-2 * time.Hour) // older than the TTL
+
+	data, err := s.Data()
+	if err != nil {
+		t.Fatalf("Data() error = %v, want the stale in-memory dataset instead of an error", err)
+	}
+	if _, ok := data.Stops["stale-in-memory"]; !ok {
+		t.Errorf("Data() = %+v, want the stale in-memory dataset", data)
+	}
+}
+
+func TestSourceDataFallsBackToStaleOnDiskOnDownloadFailure(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "gtfs.zip")
+	writeZipFile(t, cachePath, validZipBytes(t), time.Now().Add(-2*24*time.Hour))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	// No in-memory data at all, and the on-disk copy is older than the TTL,
+	// so Data() should fall all the way back to re-parsing the stale file
+	// on disk rather than returning an error.
+	s := NewSource(srv.URL, cachePath, time.Hour)
+
+	data, err := s.Data()
+	if err != nil {
+		t.Fatalf("Data() error = %v, want the stale on-disk dataset instead of an error", err)
+	}
+	if _, ok := data.Stops["723"]; !ok {
+		t.Errorf("Data() = %+v, want the stale on-disk dataset", data)
+	}
+}
+
+func TestSourceDataErrorsWithNothingToFallBackOn(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "gtfs.zip") // never created
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s := NewSource(srv.URL, cachePath, time.Hour)
+
+	if _, err := s.Data(); err == nil {
+		t.Fatal("Data() error = nil, want an error when there's no cache to fall back on")
+	}
+}
+
+func TestSourceDownloadLeavesAPreviousGoodCacheUntouchedOnCorruptBody(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "gtfs.zip")
+	goodZip := validZipBytes(t)
+	writeZipFile(t, cachePath, goodZip, time.Now().Add(-2*24*time.Hour))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Simulate a truncated/corrupt response body: not a valid zip.
+		w.Write([]byte("not a zip file"))
+	}))
+	defer srv.Close()
+
+	s := NewSource(srv.URL, cachePath, time.Hour)
+
+	// download() fails to parse the corrupt body, so Data() should fall
+	// back to the stale-but-valid on-disk cache instead of replacing it.
+	data, err := s.Data()
+	if err != nil {
+		t.Fatalf("Data() error = %v, want the stale on-disk dataset instead of an error", err)
+	}
+	if _, ok := data.Stops["723"]; !ok {
+		t.Errorf("Data() = %+v, want the stale on-disk dataset", data)
+	}
+
+	onDisk, err := os.ReadFile(cachePath)
+	if err != nil {
+		t.Fatalf("ReadFile(%q): %v", cachePath, err)
+	}
+	if !bytes.Equal(onDisk, goodZip) {
+		t.Errorf("the on-disk cache was overwritten by a corrupt download")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir(%q): %v", dir, err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("download() left stray temp files behind: %v", entries)
+	}
+}
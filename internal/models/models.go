@@ -0,0 +1,169 @@
+// Package models defines the data types returned by subwayclock's transit
+// clients, independent of the GTFS-Realtime wire format they're parsed from.
+package models
+
+import "time"
+
+// StationUpdate describes a single trip's predicted arrival and departure
+// at a stop.
+type StationUpdate struct {
+	TripID    string
+	Arrival   time.Time
+	Departure time.Time
+
+	// RouteID, RouteShortName, RouteColor, and Headsign are populated from
+	// static GTFS data (see internal/gtfs/static) and are zero-valued
+	// until a caller enriches the feed with it.
+	RouteID        string
+	RouteShortName string
+	RouteColor     string
+	Headsign       string
+}
+
+// StationStatus holds all known updates for a physical station, keyed by the
+// direction-suffixed stop ID (e.g. "723N") they were reported under.
+type StationStatus struct {
+	StopID          string
+	StopIDToUpdates map[string][]StationUpdate
+
+	// StopName, ParentStation, Latitude, and Longitude are populated from
+	// static GTFS data (see internal/gtfs/static) and are zero-valued
+	// until a caller enriches the feed with it.
+	StopName      string
+	ParentStation string
+	Latitude      float64
+	Longitude     float64
+}
+
+// ActivePeriod is a span of time during which an Alert is in effect.
+type ActivePeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// InformedEntity identifies something an Alert affects, via whichever
+// combination of route, stop, trip, and direction the agency provided.
+type InformedEntity struct {
+	RouteID     string
+	StopID      string
+	TripID      string
+	DirectionID string
+}
+
+// Alert is a service alert affecting one or more routes or stops.
+type Alert struct {
+	// ID is the GTFS-Realtime feed entity's stable identifier for this
+	// alert (FeedEntity.Id), unique within a single feed message and
+	// consistent across pushes while the alert stays active. Use this,
+	// not Effect/Header, to de-dup alerts.
+	ID string
+
+	Effect           string
+	Header           string
+	Description      string
+	Cause            string
+	SeverityLevel    string
+	ActivePeriods    []ActivePeriod
+	InformedEntities []InformedEntity
+
+	// Translations holds every translation of the alert's header text,
+	// keyed by BCP-47 language tag, so callers can pick an appropriate one
+	// instead of always getting whichever translation happened to be
+	// listed first.
+	Translations map[string]string
+}
+
+// Active reports whether the alert is in effect at t. An alert with no
+// active periods is considered always active, per the GTFS-Realtime spec.
+func (a Alert) Active(t time.Time) bool {
+	if len(a.ActivePeriods) == 0 {
+		return true
+	}
+
+	for _, p := range a.ActivePeriods {
+		if !p.Start.IsZero() && t.Before(p.Start) {
+			continue
+		}
+		if !p.End.IsZero() && !t.Before(p.End) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// FeedUpdate is the parsed result of a single GTFS-Realtime feed fetch.
+type FeedUpdate struct {
+	StationStatus map[string]StationStatus
+	Alerts        []Alert
+	Vehicles      []VehiclePosition
+}
+
+// Position is a vehicle's last reported geographic location, when the
+// publishing agency includes it.
+type Position struct {
+	Lat     float64
+	Lon     float64
+	Bearing float64
+}
+
+// VehiclePosition is a train's last reported location and status.
+type VehiclePosition struct {
+	TripID        string
+	RouteID       string
+	CurrentStopID string
+	CurrentStatus string
+	Timestamp     time.Time
+	Position      *Position
+}
+
+// VehicleForTrip returns the most recently reported position for tripID, if
+// the feed included one, so callers can tell whether a given StationUpdate's
+// train is still approaching or has already departed.
+func (f FeedUpdate) VehicleForTrip(tripID string) (VehiclePosition, bool) {
+	for _, v := range f.Vehicles {
+		if v.TripID == tripID {
+			return v, true
+		}
+	}
+
+	return VehiclePosition{}, false
+}
+
+// AlertsForStop returns every currently-active alert whose informed entities
+// include stopID.
+func (f FeedUpdate) AlertsForStop(stopID string) []Alert {
+	return f.activeAlertsMatching(func(e InformedEntity) bool {
+		return e.StopID == stopID
+	})
+}
+
+// AlertsForRoute returns every currently-active alert whose informed
+// entities include routeID.
+func (f FeedUpdate) AlertsForRoute(routeID string) []Alert {
+	return f.activeAlertsMatching(func(e InformedEntity) bool {
+		return e.RouteID == routeID
+	})
+}
+
+func (f FeedUpdate) activeAlertsMatching(match func(InformedEntity) bool) []Alert {
+	now := time.Now()
+
+	var matched []Alert
+	for _, alert := range f.Alerts {
+		if !alert.Active(now) {
+			continue
+		}
+
+		for _, e := range alert.InformedEntities {
+			if match(e) {
+				matched = append(matched, alert)
+				break
+			}
+		}
+	}
+
+	return matched
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlertActive(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	tests := []struct {
+		name string
+		a    Alert
+		want bool
+	}{
+		{
+			name: "no active periods is always active",
+			a:    Alert{},
+			want: true,
+		},
+		{
+			name: "within a bounded period",
+			a:    Alert{ActivePeriods: []ActivePeriod{{Start: now.Add(-time.Hour), End: now.Add(time.Hour)}}},
+			want: true,
+		},
+		{
+			name: "before a bounded period",
+			a:    Alert{ActivePeriods: []ActivePeriod{{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}}},
+			want: false,
+		},
+		{
+			name: "after a bounded period",
+			a:    Alert{ActivePeriods: []ActivePeriod{{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)}}},
+			want: false,
+		},
+		{
+			name: "open-ended start (zero Start means always started)",
+			a:    Alert{ActivePeriods: []ActivePeriod{{End: now.Add(time.Hour)}}},
+			want: true,
+		},
+		{
+			name: "open-ended end (zero End means never ends)",
+			a:    Alert{ActivePeriods: []ActivePeriod{{Start: now.Add(-time.Hour)}}},
+			want: true,
+		},
+		{
+			name: "exactly at End is no longer active",
+			a:    Alert{ActivePeriods: []ActivePeriod{{Start: now.Add(-time.Hour), End: now}}},
+			want: false,
+		},
+		{
+			name: "exactly at Start is active",
+			a:    Alert{ActivePeriods: []ActivePeriod{{Start: now, End: now.Add(time.Hour)}}},
+			want: true,
+		},
+		{
+			name: "matches the second of several periods",
+			a: Alert{ActivePeriods: []ActivePeriod{
+				{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)},
+				{Start: now.Add(-time.Minute), End: now.Add(time.Minute)},
+			}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Active(now); got != tt.want {
+				t.Errorf("Active() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlertsForStopAndRoute(t *testing.T) {
+	now := time.Now()
+
+	active := Alert{
+		ID: "active",
+		InformedEntities: []InformedEntity{
+			{RouteID: "7", StopID: "723"},
+		},
+	}
+	expired := Alert{
+		ID: "expired",
+		ActivePeriods: []ActivePeriod{
+			{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)},
+		},
+		InformedEntities: []InformedEntity{
+			{RouteID: "7", StopID: "723"},
+		},
+	}
+	otherStop := Alert{
+		ID: "other-stop",
+		InformedEntities: []InformedEntity{
+			{RouteID: "7", StopID: "125"},
+		},
+	}
+
+	update := FeedUpdate{Alerts: []Alert{active, expired, otherStop}}
+
+	t.Run("AlertsForStop excludes expired and non-matching alerts", func(t *testing.T) {
+		got := update.AlertsForStop("723")
+		if len(got) != 1 || got[0].ID != "active" {
+			t.Errorf("AlertsForStop(723) = %+v, want only [active]", got)
+		}
+	})
+
+	t.Run("AlertsForRoute excludes expired but includes every matching stop", func(t *testing.T) {
+		got := update.AlertsForRoute("7")
+		if len(got) != 2 {
+			t.Fatalf("AlertsForRoute(7) = %+v, want 2 active alerts", got)
+		}
+		for _, a := range got {
+			if a.ID == "expired" {
+				t.Errorf("AlertsForRoute(7) included expired alert: %+v", got)
+			}
+		}
+	})
+}
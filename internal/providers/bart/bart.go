@@ -0,0 +1,40 @@
+// Package bart adapts the Bay Area Rapid Transit District's realtime feed to
+// client.Provider.
+package bart
+
+import "net/http"
+
+const _feedURL = "https://api.bart.gov/gtfsrt/tripupdate.aspx"
+
+// Config defines how to configure a Provider for BART's realtime feed.
+type Config struct {
+	APIKey string `yaml:"api_key" json:"api_key"`
+}
+
+// Provider is a client.Provider for BART's GTFS-Realtime feed.
+type Provider struct {
+	cfg Config
+}
+
+// New creates a Provider for cfg.
+func New(cfg Config) *Provider {
+	return &Provider{cfg: cfg}
+}
+
+// FeedURL returns the URL to fetch BART's feed from.
+func (p *Provider) FeedURL() string {
+	return _feedURL
+}
+
+// AuthorizeRequest adds BART's key query parameter to req.
+func (p *Provider) AuthorizeRequest(req *http.Request) {
+	q := req.URL.Query()
+	q.Set("key", p.cfg.APIKey)
+	req.URL.RawQuery = q.Encode()
+}
+
+// StopIDDirection is a no-op for BART: its stop IDs don't encode direction,
+// it's carried on the trip's direction_id instead.
+func (p *Provider) StopIDDirection(stopID string) (base, dir string) {
+	return stopID, ""
+}
@@ -0,0 +1,37 @@
+// Package lametro adapts LA Metro's realtime rail feed to client.Provider.
+package lametro
+
+import "net/http"
+
+const _feedURL = "https://api.metro.net/gtfs_rt/rail/tripupdates"
+
+// Config defines how to configure a Provider for LA Metro's realtime feed.
+type Config struct {
+	APIKey string `yaml:"api_key" json:"api_key"`
+}
+
+// Provider is a client.Provider for LA Metro's GTFS-Realtime feed.
+type Provider struct {
+	cfg Config
+}
+
+// New creates a Provider for cfg.
+func New(cfg Config) *Provider {
+	return &Provider{cfg: cfg}
+}
+
+// FeedURL returns the URL to fetch LA Metro's feed from.
+func (p *Provider) FeedURL() string {
+	return _feedURL
+}
+
+// AuthorizeRequest adds LA Metro's api_key header to req.
+func (p *Provider) AuthorizeRequest(req *http.Request) {
+	req.Header.Add("api_key", p.cfg.APIKey)
+}
+
+// StopIDDirection is a no-op for LA Metro: its stop IDs don't encode
+// direction, it's carried on the trip's direction_id instead.
+func (p *Provider) StopIDDirection(stopID string) (base, dir string) {
+	return stopID, ""
+}
@@ -0,0 +1,62 @@
+// Package nyct adapts the New York City Transit Authority's realtime subway
+// feeds to client.Provider.
+package nyct
+
+import "net/http"
+
+// The MTA considers one physical station to be multiple stop IDs depending
+// on the direction a train is travelling. For example, Grand Central on the 7
+// line would have two stop IDs: 723N and 723S. 723N would be Grand Central on
+// the 7 line in the direction of travel in Queens.
+const (
+	_northboundSuffx = 'N'
+	_soutboundSuffx  = 'S'
+)
+
+const _baseURL = "https://api-endpoint.mta.info/Dataservice/mtagtfsfeeds/nyct%2Fgtfs-"
+
+// Config defines how to configure a Provider for a single NYCT subway feed.
+type Config struct {
+	APIKey string `yaml:"api_key" json:"api_key"`
+	FeedID string `yaml:"feed_id" json:"feed_id"`
+}
+
+// Provider is a client.Provider for one of the MTA's NYCT subway feeds.
+type Provider struct {
+	cfg Config
+	url string
+}
+
+// New creates a Provider for cfg.
+func New(cfg Config) *Provider {
+	return &Provider{
+		cfg: cfg,
+		url: _baseURL + cfg.FeedID,
+	}
+}
+
+// FeedURL returns the URL to fetch this provider's feed from.
+func (p *Provider) FeedURL() string {
+	return p.url
+}
+
+// AuthorizeRequest adds the MTA's x-api-key header to req.
+func (p *Provider) AuthorizeRequest(req *http.Request) {
+	req.Header.Add("x-api-key", p.cfg.APIKey)
+}
+
+// StopIDDirection splits a direction-suffixed NYCT stop ID (e.g. "723N")
+// into its base station ID and direction. Stop IDs without a recognized
+// direction suffix are returned unchanged with an empty direction.
+func (p *Provider) StopIDDirection(stopID string) (base, dir string) {
+	if len(stopID) < 2 {
+		return stopID, ""
+	}
+
+	last := stopID[len(stopID)-1]
+	if last != _northboundSuffx && last != _soutboundSuffx {
+		return stopID, ""
+	}
+
+	return stopID[:len(stopID)-1], string(last)
+}
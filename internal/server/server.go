@@ -0,0 +1,190 @@
+// Package server exposes a FeedUpdate-backed station-departures and alerts
+// API over HTTP.
+package server
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+)
+
+const _defaultDeparturesLimit = 10
+
+// _maxDeparturesLimit caps the user-supplied limit query param so a request
+// like limit=2000000000 can't force upcomingDepartures to preallocate an
+// enormous slice.
+const _maxDeparturesLimit = 500
+
+// feedGetter is satisfied by client.CachingClient, client.Client, and
+// client.AggregatedNYCTA. Accepting the interface here, rather than a
+// concrete type, keeps this package from needing to import internal/client.
+type feedGetter interface {
+	GetFeed() (models.FeedUpdate, error)
+}
+
+// Departure is a single upcoming train at a stop, shaped for direct JSON
+// serving to a subway-clock UI.
+type Departure struct {
+	Route         string `json:"route"`
+	Headsign      string `json:"headsign"`
+	TripID        string `json:"trip_id"`
+	ArrivalUnix   int64  `json:"arrival_unix"`
+	DepartureUnix int64  `json:"departure_unix"`
+	Minutes       int    `json:"minutes"`
+}
+
+// Server serves station departures and alerts from a feedGetter, which
+// should be a client.CachingClient wrapping a client.AggregatedNYCTA so the
+// HTTP layer serves the whole system and never spams the upstream feeds
+// regardless of request volume.
+type Server struct {
+	feed feedGetter
+	mux  *http.ServeMux
+}
+
+// New creates a Server backed by feed.
+func New(feed feedGetter) *Server {
+	s := &Server{
+		feed: feed,
+		mux:  http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /v1/stations/{stopID}/departures", s.handleDepartures)
+	s.mux.HandleFunc("GET /v1/alerts", s.handleAlerts)
+
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleDepartures(w http.ResponseWriter, r *http.Request) {
+	stopID := r.PathValue("stopID")
+	direction := r.URL.Query().Get("direction")
+
+	limit := _defaultDeparturesLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 || parsed > _maxDeparturesLimit {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	update, err := s.feed.GetFeed()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	departures := upcomingDepartures(update.StationStatus[stopID], direction, limit, time.Now())
+
+	writeJSON(w, departures)
+}
+
+func (s *Server) handleAlerts(w http.ResponseWriter, r *http.Request) {
+	routeID := r.URL.Query().Get("route")
+	stopID := r.URL.Query().Get("stop")
+
+	update, err := s.feed.GetFeed()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	writeJSON(w, filterAlerts(update, routeID, stopID))
+}
+
+// upcomingDepartures collects every StationUpdate for stop matching
+// direction (all directions if direction is empty), drops anything whose
+// arrival has already passed, sorts by arrival, and caps the result at
+// limit.
+func upcomingDepartures(stop models.StationStatus, direction string, limit int, now time.Time) []Departure {
+	var updates []models.StationUpdate
+	for dirStopID, dirUpdates := range stop.StopIDToUpdates {
+		if direction != "" && dirStopID != stop.StopID+direction {
+			continue
+		}
+		updates = append(updates, dirUpdates...)
+	}
+
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].Arrival.Before(updates[j].Arrival)
+	})
+
+	departures := make([]Departure, 0, limit)
+	for _, u := range updates {
+		if len(departures) >= limit {
+			break
+		}
+		if u.Arrival.Before(now) {
+			continue
+		}
+
+		departures = append(departures, Departure{
+			Route:         u.RouteShortName,
+			Headsign:      u.Headsign,
+			TripID:        u.TripID,
+			ArrivalUnix:   u.Arrival.Unix(),
+			DepartureUnix: u.Departure.Unix(),
+			Minutes:       minutesUntil(u.Arrival, now),
+		})
+	}
+
+	return departures
+}
+
+func minutesUntil(arrival, now time.Time) int {
+	minutes := int(math.Round(arrival.Sub(now).Seconds() / 60))
+	if minutes < 0 {
+		minutes = 0
+	}
+
+	return minutes
+}
+
+// filterAlerts returns every currently-active alert in update matching
+// routeID or stopID. If both are given, an alert matching either is
+// included. If neither is given, every alert is returned, active or not.
+func filterAlerts(update models.FeedUpdate, routeID, stopID string) []models.Alert {
+	if routeID == "" && stopID == "" {
+		return update.Alerts
+	}
+
+	seen := make(map[string]bool)
+	alerts := []models.Alert{}
+
+	addAll := func(matches []models.Alert) {
+		for _, a := range matches {
+			if seen[a.ID] {
+				continue
+			}
+			seen[a.ID] = true
+			alerts = append(alerts, a)
+		}
+	}
+
+	if routeID != "" {
+		addAll(update.AlertsForRoute(routeID))
+	}
+	if stopID != "" {
+		addAll(update.AlertsForStop(stopID))
+	}
+
+	return alerts
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
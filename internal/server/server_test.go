@@ -0,0 +1,141 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ztstewart/subwayclock/internal/models"
+)
+
+func TestMinutesUntil(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	tests := []struct {
+		name    string
+		arrival time.Time
+		want    int
+	}{
+		{"in the future", now.Add(90 * time.Second), 2},
+		{"rounds down just under the half-minute", now.Add(29 * time.Second), 0},
+		{"rounds up just past the half-minute", now.Add(31 * time.Second), 1},
+		{"already arrived", now.Add(-time.Minute), 0},
+		{"exactly now", now, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := minutesUntil(tt.arrival, now); got != tt.want {
+				t.Errorf("minutesUntil() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpcomingDepartures(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	stop := models.StationStatus{
+		StopID: "723",
+		StopIDToUpdates: map[string][]models.StationUpdate{
+			"723N": {
+				{TripID: "past", Arrival: now.Add(-time.Minute), RouteShortName: "7"},
+				{TripID: "soon", Arrival: now.Add(2 * time.Minute), RouteShortName: "7"},
+				{TripID: "later", Arrival: now.Add(5 * time.Minute), RouteShortName: "7"},
+			},
+			"723S": {
+				{TripID: "southbound", Arrival: now.Add(3 * time.Minute), RouteShortName: "7"},
+			},
+		},
+	}
+
+	t.Run("drops past arrivals and sorts the rest", func(t *testing.T) {
+		got := upcomingDepartures(stop, "N", 10, now)
+
+		if len(got) != 2 {
+			t.Fatalf("upcomingDepartures() = %+v, want 2 departures", got)
+		}
+		if got[0].TripID != "soon" || got[1].TripID != "later" {
+			t.Errorf("upcomingDepartures() = %+v, want [soon, later]", got)
+		}
+	})
+
+	t.Run("empty direction includes every direction", func(t *testing.T) {
+		got := upcomingDepartures(stop, "", 10, now)
+
+		if len(got) != 3 {
+			t.Fatalf("upcomingDepartures() = %+v, want 3 departures across both directions", got)
+		}
+	})
+
+	t.Run("caps at limit", func(t *testing.T) {
+		got := upcomingDepartures(stop, "", 1, now)
+
+		if len(got) != 1 {
+			t.Fatalf("upcomingDepartures() = %+v, want 1 departure", got)
+		}
+	})
+
+	t.Run("computes minutes and unix timestamps", func(t *testing.T) {
+		got := upcomingDepartures(stop, "N", 10, now)
+
+		if got[0].Minutes != 2 {
+			t.Errorf("got[0].Minutes = %d, want 2", got[0].Minutes)
+		}
+		if got[0].ArrivalUnix != now.Add(2*time.Minute).Unix() {
+			t.Errorf("got[0].ArrivalUnix = %d, want %d", got[0].ArrivalUnix, now.Add(2*time.Minute).Unix())
+		}
+	})
+}
+
+func TestFilterAlerts(t *testing.T) {
+	routeAlert := models.Alert{
+		ID:               "route-alert",
+		InformedEntities: []models.InformedEntity{{RouteID: "7"}},
+	}
+	stopAlert := models.Alert{
+		ID:               "stop-alert",
+		InformedEntities: []models.InformedEntity{{StopID: "723"}},
+	}
+	bothAlert := models.Alert{
+		ID:               "both-alert",
+		InformedEntities: []models.InformedEntity{{RouteID: "7", StopID: "723"}},
+	}
+	unrelatedAlert := models.Alert{
+		ID:               "unrelated-alert",
+		InformedEntities: []models.InformedEntity{{RouteID: "A"}},
+	}
+
+	update := models.FeedUpdate{
+		Alerts: []models.Alert{routeAlert, stopAlert, bothAlert, unrelatedAlert},
+	}
+
+	t.Run("no filter returns everything", func(t *testing.T) {
+		got := filterAlerts(update, "", "")
+		if len(got) != 4 {
+			t.Fatalf("filterAlerts() = %+v, want all 4 alerts", got)
+		}
+	})
+
+	t.Run("route filter", func(t *testing.T) {
+		got := filterAlerts(update, "7", "")
+		if len(got) != 2 {
+			t.Fatalf("filterAlerts() = %+v, want 2 alerts matching route 7", got)
+		}
+	})
+
+	t.Run("route and stop filters are unioned without duplicates", func(t *testing.T) {
+		got := filterAlerts(update, "7", "723")
+
+		if len(got) != 3 {
+			t.Fatalf("filterAlerts() = %+v, want 3 deduped alerts", got)
+		}
+
+		seen := make(map[string]bool)
+		for _, a := range got {
+			if seen[a.ID] {
+				t.Errorf("filterAlerts() returned duplicate alert %q", a.ID)
+			}
+			seen[a.ID] = true
+		}
+	})
+}